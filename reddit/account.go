@@ -0,0 +1,117 @@
+package reddit
+
+import "context"
+
+// Account is the set of actions a bot can take as its authenticated user.
+type Account interface {
+	// Reply posts a comment in reply to name (a post or comment fullname).
+	Reply(ctx context.Context, name, text string) error
+	// GetReply is like Reply, but returns the comment that was created.
+	GetReply(ctx context.Context, name, text string) (*Comment, error)
+
+	// SendMessage sends a private message to user.
+	SendMessage(ctx context.Context, user, subject, text string) error
+
+	// PostSelf submits a self (text) post to a subreddit.
+	PostSelf(ctx context.Context, subreddit, title, text string) error
+	// GetPostSelf is like PostSelf, but returns the post that was created.
+	GetPostSelf(ctx context.Context, subreddit, title, text string) (*Post, error)
+
+	// PostLink submits a link post to a subreddit.
+	PostLink(ctx context.Context, subreddit, title, url string) error
+	// GetPostLink is like PostLink, but returns the post that was created.
+	GetPostLink(ctx context.Context, subreddit, title, url string) (*Post, error)
+}
+
+type accountImpl struct {
+	rep reaper
+}
+
+func newAccount(r reaper) Account {
+	return &accountImpl{rep: r}
+}
+
+func (a *accountImpl) Reply(ctx context.Context, name, text string) error {
+	return a.rep.sow(ctx, "/api/comment", map[string]string{
+		"thing_id": name,
+		"text":     text,
+	})
+}
+
+func (a *accountImpl) GetReply(ctx context.Context, name, text string) (*Comment, error) {
+	h, err := a.rep.getSow(ctx, "/api/comment", map[string]string{
+		"thing_id": name,
+		"text":     text,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(h.Comments) == 0 {
+		return nil, nil
+	}
+	return h.Comments[0], nil
+}
+
+func (a *accountImpl) SendMessage(ctx context.Context, user, subject, text string) error {
+	return a.rep.sow(ctx, "/api/compose", map[string]string{
+		"to":      user,
+		"subject": subject,
+		"text":    text,
+	})
+}
+
+func (a *accountImpl) PostSelf(ctx context.Context, subreddit, title, text string) error {
+	return a.rep.sow(ctx, "/api/submit", map[string]string{
+		"kind":  "self",
+		"sr":    subreddit,
+		"title": title,
+		"text":  text,
+	})
+}
+
+func (a *accountImpl) GetPostSelf(
+	ctx context.Context,
+	subreddit, title, text string,
+) (*Post, error) {
+	h, err := a.rep.getSow(ctx, "/api/submit", map[string]string{
+		"kind":  "self",
+		"sr":    subreddit,
+		"title": title,
+		"text":  text,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(h.Posts) == 0 {
+		return nil, nil
+	}
+	return h.Posts[0], nil
+}
+
+func (a *accountImpl) PostLink(ctx context.Context, subreddit, title, url string) error {
+	return a.rep.sow(ctx, "/api/submit", map[string]string{
+		"kind":  "link",
+		"sr":    subreddit,
+		"title": title,
+		"url":   url,
+	})
+}
+
+func (a *accountImpl) GetPostLink(
+	ctx context.Context,
+	subreddit, title, url string,
+) (*Post, error) {
+	h, err := a.rep.getSow(ctx, "/api/submit", map[string]string{
+		"kind":  "link",
+		"sr":    subreddit,
+		"title": title,
+		"url":   url,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(h.Posts) == 0 {
+		return nil, nil
+	}
+	return h.Posts[0], nil
+}
@@ -0,0 +1,76 @@
+package reddit
+
+import (
+	"net/http"
+	"time"
+)
+
+// Bot is a Reddit user session that can read and act on content.
+type Bot interface {
+	Account
+	Lurker
+	Scanner
+}
+
+type bot struct {
+	Account
+	Lurker
+	Scanner
+}
+
+// BotConfig configures a Bot.
+type BotConfig struct {
+	// Agent is the user agent sent with every request.
+	Agent string
+	// Hostname is the Reddit host to talk to, e.g. "reddit.com".
+	Hostname string
+	// TLS enables https. Reddit requires it outside of tests.
+	TLS bool
+	// Rate is the fallback pacing used between requests when Reddit's
+	// rate limit headers are absent.
+	Rate time.Duration
+	// Client is the underlying HTTP client to use. If nil,
+	// http.DefaultClient is used.
+	Client *http.Client
+}
+
+// BotOption configures optional Bot behavior beyond BotConfig.
+type BotOption func(*botOptions)
+
+type botOptions struct {
+	interceptors []Interceptor
+}
+
+// WithInterceptor adds Interceptors to the chain wrapping every request the
+// Bot sends. When multiple interceptors are given (here or across repeated
+// WithInterceptor options), the first one is outermost.
+func WithInterceptor(interceptors ...Interceptor) BotOption {
+	return func(o *botOptions) {
+		o.interceptors = append(o.interceptors, interceptors...)
+	}
+}
+
+// NewBot returns a Bot configured by cfg and opts.
+func NewBot(cfg BotConfig, opts ...BotOption) (Bot, error) {
+	var o botOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	r := newReaper(reaperConfig{
+		client:       newClient(cfg.Client),
+		parser:       newParser(),
+		hostname:     cfg.Hostname,
+		reapSuffix:   ".json",
+		tls:          cfg.TLS,
+		rate:         cfg.Rate,
+		interceptors: o.interceptors,
+		agent:        cfg.Agent,
+	})
+
+	return &bot{
+		Account: newAccount(r),
+		Lurker:  newLurker(r),
+		Scanner: newScanner(r),
+	}, nil
+}
@@ -0,0 +1,29 @@
+package reddit
+
+import "net/http"
+
+// RoundTripper executes a single HTTP request against Reddit, mirroring
+// net/http.RoundTripper's resp, err contract so Interceptors can inspect
+// status codes before the reaper consumes the response body.
+type RoundTripper interface {
+	Do(*http.Request) (*http.Response, error)
+}
+
+// httpClient is the base RoundTripper, backed by a real *http.Client.
+type httpClient struct {
+	cli *http.Client
+}
+
+// newClient returns the base RoundTripper a Bot dispatches requests
+// through, before any Interceptors are applied. If cli is nil,
+// http.DefaultClient is used.
+func newClient(cli *http.Client) RoundTripper {
+	if cli == nil {
+		cli = http.DefaultClient
+	}
+	return &httpClient{cli: cli}
+}
+
+func (h *httpClient) Do(req *http.Request) (*http.Response, error) {
+	return h.cli.Do(req)
+}
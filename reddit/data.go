@@ -0,0 +1,48 @@
+package reddit
+
+// Comment represents a comment on Reddit (Reddit type t1_).
+type Comment struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	Permalink string `json:"permalink"`
+
+	Author   string `json:"author"`
+	Body     string `json:"body"`
+	BodyHTML string `json:"body_html"`
+
+	Subreddit string `json:"subreddit"`
+	ParentID  string `json:"parent_id"`
+}
+
+// Post represents a post on Reddit (Reddit type t3_).
+type Post struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	Permalink string `json:"permalink"`
+
+	Author string `json:"author"`
+	Title  string `json:"title"`
+
+	Subreddit    string `json:"subreddit"`
+	IsSelf       bool   `json:"is_self"`
+	SelfText     string `json:"selftext"`
+	SelfTextHTML string `json:"selftext_html"`
+	URL          string `json:"url"`
+}
+
+// Message represents a message on Reddit (Reddit type t4_).
+type Message struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+
+	Author  string `json:"author"`
+	Subject string `json:"subject"`
+	Body    string `json:"body"`
+}
+
+// Harvest is the set of entities a reap call returned.
+type Harvest struct {
+	Comments []*Comment
+	Posts    []*Post
+	Messages []*Message
+}
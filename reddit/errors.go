@@ -0,0 +1,7 @@
+package reddit
+
+import "errors"
+
+// ThreadDoesNotExistErr is returned by Thread when the requested permalink
+// does not resolve to a post.
+var ThreadDoesNotExistErr = errors.New("reddit: thread does not exist")
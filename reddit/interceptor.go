@@ -0,0 +1,17 @@
+package reddit
+
+// Interceptor wraps a RoundTripper in another RoundTripper, letting it
+// inspect or alter a request before passing it on and the response before
+// returning it. Bot runs every outgoing request through the chain built by
+// chainInterceptors.
+type Interceptor func(next RoundTripper) RoundTripper
+
+// chainInterceptors wraps base in interceptors so the first Interceptor in
+// the slice is the outermost: it sees each request first and each response
+// last. A nil or empty slice returns base unchanged.
+func chainInterceptors(base RoundTripper, interceptors []Interceptor) RoundTripper {
+	for i := len(interceptors) - 1; i >= 0; i-- {
+		base = interceptors[i](base)
+	}
+	return base
+}
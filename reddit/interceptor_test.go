@@ -0,0 +1,58 @@
+package reddit
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+// recordingInterceptor appends name to a shared trace when the request
+// passes through it, both on the way in and on the way back out, so tests
+// can assert stacking order.
+func recordingInterceptor(trace *[]string, name string) Interceptor {
+	return func(next RoundTripper) RoundTripper {
+		return roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			*trace = append(*trace, name+":in")
+			resp, err := next.Do(req)
+			*trace = append(*trace, name+":out")
+			return resp, err
+		})
+	}
+}
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) Do(req *http.Request) (*http.Response, error) { return f(req) }
+
+func TestInterceptorOrder(t *testing.T) {
+	var trace []string
+	c := &mockClient{}
+
+	r := newReaper(reaperConfig{
+		client:   c,
+		parser:   &mockParser{},
+		hostname: "com",
+		interceptors: []Interceptor{
+			recordingInterceptor(&trace, "outer"),
+			recordingInterceptor(&trace, "inner"),
+		},
+	})
+
+	if _, err := r.reap(context.Background(), "", nil); err != nil {
+		t.Fatalf("reap: %v", err)
+	}
+
+	want := []string{"outer:in", "inner:in", "inner:out", "outer:out"}
+	if len(trace) != len(want) {
+		t.Fatalf("trace = %v, want %v", trace, want)
+	}
+	for i := range want {
+		if trace[i] != want[i] {
+			t.Errorf("trace[%d] = %s, want %s", i, trace[i], want[i])
+		}
+	}
+
+	if c.request == nil {
+		t.Fatal("interceptors did not forward the request to the base client")
+	}
+}
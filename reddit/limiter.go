@@ -0,0 +1,109 @@
+package reddit
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// clock abstracts time so limiter can be exercised with a fake clock in
+// tests.
+type clock interface {
+	Now() time.Time
+	After(d time.Duration) <-chan time.Time
+}
+
+type realClock struct{}
+
+func (realClock) Now() time.Time { return time.Now() }
+
+func (realClock) After(d time.Duration) <-chan time.Time { return time.After(d) }
+
+// limiter paces outgoing requests to Reddit.
+type limiter interface {
+	// wait blocks the caller until the next request may be sent, or
+	// returns ctx.Err() if ctx is done first.
+	wait(ctx context.Context) error
+	// update reschedules the next allowed request from a response's
+	// rate limit headers, or, if none are present, from the limiter's
+	// static fallback rate.
+	update(header http.Header)
+}
+
+// rateLimiter paces requests by a single next allowed slot, set after each
+// response from Reddit's X-Ratelimit-Remaining and X-Ratelimit-Reset
+// response headers (the next slot is reset/remaining seconds out, or reset
+// seconds out if remaining has hit zero). It keeps no token count: each
+// update replaces the next slot. X-Ratelimit-Used is informational only
+// and isn't needed to schedule the next slot, so it isn't read. When Remaining/Reset are
+// absent (hosts other than reddit.com, or unauthenticated requests) the
+// limiter falls back to a fixed rate between requests.
+type rateLimiter struct {
+	rate time.Duration
+	clk  clock
+
+	mu       sync.Mutex
+	nextSlot time.Time
+}
+
+func newLimiter(rate time.Duration) *rateLimiter {
+	return &rateLimiter{rate: rate, clk: realClock{}}
+}
+
+func (l *rateLimiter) wait(ctx context.Context) error {
+	l.mu.Lock()
+	d := l.nextSlot.Sub(l.clk.Now())
+	l.mu.Unlock()
+
+	if d <= 0 {
+		return nil
+	}
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	select {
+	case <-l.clk.After(d):
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (l *rateLimiter) update(header http.Header) {
+	remaining, hasRemaining := parseRatelimitHeader(header, "X-Ratelimit-Remaining")
+	reset, hasReset := parseRatelimitHeader(header, "X-Ratelimit-Reset")
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.clk.Now()
+	if !hasRemaining || !hasReset {
+		l.nextSlot = now.Add(l.rate)
+		return
+	}
+
+	if remaining <= 0 {
+		l.nextSlot = now.Add(time.Duration(reset * float64(time.Second)))
+		return
+	}
+
+	slot := time.Duration(reset / remaining * float64(time.Second))
+	l.nextSlot = now.Add(slot)
+}
+
+func parseRatelimitHeader(header http.Header, key string) (float64, bool) {
+	raw := header.Get(key)
+	if raw == "" {
+		return 0, false
+	}
+
+	v, err := strconv.ParseFloat(raw, 64)
+	if err != nil {
+		return 0, false
+	}
+	return v, true
+}
@@ -0,0 +1,96 @@
+package reddit
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+)
+
+// fakeClock is a clock whose After fires as soon as it is called, having
+// first advanced now by the requested duration. This lets tests exercise
+// limiter's scheduling logic without sleeping in real time.
+type fakeClock struct {
+	now time.Time
+}
+
+func (f *fakeClock) Now() time.Time { return f.now }
+
+func (f *fakeClock) After(d time.Duration) <-chan time.Time {
+	f.now = f.now.Add(d)
+	c := make(chan time.Time, 1)
+	c <- f.now
+	return c
+}
+
+func TestRateLimiterFallback(t *testing.T) {
+	clk := &fakeClock{now: time.Unix(0, 0)}
+	l := &rateLimiter{rate: 10 * time.Millisecond, clk: clk}
+
+	if err := l.wait(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	l.update(http.Header{})
+
+	start := clk.now
+	if err := l.wait(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if elapsed := clk.now.Sub(start); elapsed < l.rate {
+		t.Errorf("wanted block for %v; blocked for %v", l.rate, elapsed)
+	}
+}
+
+func TestRateLimiterHeaders(t *testing.T) {
+	clk := &fakeClock{now: time.Unix(0, 0)}
+	l := &rateLimiter{rate: time.Second, clk: clk}
+
+	l.update(http.Header{
+		"X-Ratelimit-Used":      []string{"1"},
+		"X-Ratelimit-Remaining": []string{"4"},
+		"X-Ratelimit-Reset":     []string{"8"},
+	})
+
+	wantSlot := clk.now.Add(2 * time.Second)
+	if !l.nextSlot.Equal(wantSlot) {
+		t.Errorf("nextSlot = %v, want %v", l.nextSlot, wantSlot)
+	}
+
+	start := clk.now
+	if err := l.wait(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if elapsed := clk.now.Sub(start); elapsed != 2*time.Second {
+		t.Errorf("wanted block for 2s; blocked for %v", elapsed)
+	}
+}
+
+func TestRateLimiterRemainingZeroBlocksUntilReset(t *testing.T) {
+	clk := &fakeClock{now: time.Unix(0, 0)}
+	l := &rateLimiter{rate: time.Second, clk: clk}
+
+	l.update(http.Header{
+		"X-Ratelimit-Used":      []string{"60"},
+		"X-Ratelimit-Remaining": []string{"0"},
+		"X-Ratelimit-Reset":     []string{"30"},
+	})
+
+	wantSlot := clk.now.Add(30 * time.Second)
+	if !l.nextSlot.Equal(wantSlot) {
+		t.Errorf("nextSlot = %v, want %v", l.nextSlot, wantSlot)
+	}
+}
+
+func TestRateLimiterWaitCancelled(t *testing.T) {
+	clk := &fakeClock{now: time.Unix(0, 0)}
+	l := &rateLimiter{rate: time.Second, clk: clk}
+	l.nextSlot = clk.now.Add(time.Hour)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if err := l.wait(ctx); err != ctx.Err() {
+		t.Errorf("wait() = %v, want %v", err, ctx.Err())
+	}
+}
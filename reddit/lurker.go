@@ -0,0 +1,31 @@
+package reddit
+
+import "context"
+
+// Lurker reads individual pieces of content from Reddit without acting on
+// them.
+type Lurker interface {
+	// Thread returns the post at permalink.
+	Thread(ctx context.Context, permalink string) (*Post, error)
+}
+
+type lurkerImpl struct {
+	rep reaper
+}
+
+func newLurker(r reaper) Lurker {
+	return &lurkerImpl{rep: r}
+}
+
+func (l *lurkerImpl) Thread(ctx context.Context, permalink string) (*Post, error) {
+	h, err := l.rep.reap(ctx, permalink, map[string]string{
+		"raw_json": "1",
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(h.Posts) == 0 {
+		return nil, ThreadDoesNotExistErr
+	}
+	return h.Posts[0], nil
+}
@@ -0,0 +1,118 @@
+// Package middleware provides reddit.Interceptors for common cross-cutting
+// request behavior: retrying failed requests, and logging them.
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"time"
+
+	"github.com/turnage/graw/reddit"
+)
+
+// Retry returns an Interceptor that retries a request up to max additional
+// times when classifier reports the response or error as retriable,
+// backing off exponentially with jitter between attempts. A typical
+// classifier retries on 5xx and 429 responses and on transport errors:
+//
+//	func(resp *http.Response, err error) bool {
+//		return err != nil || resp.StatusCode == 429 || resp.StatusCode >= 500
+//	}
+//
+// Retry rewinds req.Body via req.GetBody before each retry, so it is safe
+// to use on requests with no body or a body built with a GetBody func (as
+// net/http.NewRequest and reddit.Bot's requests both do). A
+// request with a body but no GetBody can't be safely resent, so Retry
+// passes its result through without retrying rather than risk resending a
+// truncated or empty body. It closes the body of each discarded response
+// before retrying, and honors req.Context() during its backoff sleep so a
+// cancelled request can interrupt it.
+func Retry(max int, classifier func(*http.Response, error) bool) reddit.Interceptor {
+	return func(next reddit.RoundTripper) reddit.RoundTripper {
+		return retrier{next: next, max: max, classifier: classifier}
+	}
+}
+
+type retrier struct {
+	next       reddit.RoundTripper
+	max        int
+	classifier func(*http.Response, error) bool
+}
+
+func (r retrier) Do(req *http.Request) (*http.Response, error) {
+	resp, err := r.next.Do(req)
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return resp, err
+	}
+
+	for attempt := 0; attempt < r.max && r.classifier(resp, err); attempt++ {
+		if resp != nil && resp.Body != nil {
+			resp.Body.Close()
+		}
+
+		if err := sleep(req.Context(), backoff(attempt)); err != nil {
+			return nil, err
+		}
+
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req.Body = body
+		}
+		resp, err = r.next.Do(req)
+	}
+	return resp, err
+}
+
+// sleep waits for d, or returns ctx.Err() if ctx is done first, so a
+// cancelled request can interrupt an in-flight retry backoff.
+func sleep(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// backoff returns the delay before the attempt'th retry: 100ms doubling
+// each attempt, plus up to 50% jitter so concurrent bots don't retry in
+// lockstep.
+func backoff(attempt int) time.Duration {
+	base := 100 * time.Millisecond << uint(attempt)
+	return base + time.Duration(rand.Int63n(int64(base)/2+1))
+}
+
+// Logger returns an Interceptor that writes one line to w per request,
+// recording its method, path, status, and latency.
+func Logger(w io.Writer) reddit.Interceptor {
+	return func(next reddit.RoundTripper) reddit.RoundTripper {
+		return logger{next: next, w: w}
+	}
+}
+
+type logger struct {
+	next reddit.RoundTripper
+	w    io.Writer
+}
+
+func (l logger) Do(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	resp, err := l.next.Do(req)
+
+	status := "-"
+	if resp != nil {
+		status = fmt.Sprintf("%d", resp.StatusCode)
+	}
+	fmt.Fprintf(l.w, "%s %s %s %s\n", req.Method, req.URL.Path, status, time.Since(start))
+
+	return resp, err
+}
@@ -0,0 +1,263 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/turnage/graw/reddit"
+)
+
+// closeCountingBody tracks whether Close was called on it.
+type closeCountingBody struct {
+	closed bool
+}
+
+func (b *closeCountingBody) Read([]byte) (int, error) { return 0, nil }
+func (b *closeCountingBody) Close() error             { b.closed = true; return nil }
+
+// fakeTripper returns a canned response/error, counting how many times it
+// was called.
+type fakeTripper struct {
+	calls     int
+	responses []*http.Response
+	errs      []error
+}
+
+func (f *fakeTripper) Do(req *http.Request) (*http.Response, error) {
+	i := f.calls
+	f.calls++
+	if i < len(f.responses) {
+		return f.responses[i], f.errs[i]
+	}
+	last := len(f.responses) - 1
+	return f.responses[last], f.errs[last]
+}
+
+func resp(status int) *http.Response {
+	return &http.Response{StatusCode: status}
+}
+
+func retriable(resp *http.Response, err error) bool {
+	return err != nil || resp.StatusCode == 429 || resp.StatusCode >= 500
+}
+
+func TestRetrySucceedsAfterFailures(t *testing.T) {
+	tripper := &fakeTripper{
+		responses: []*http.Response{resp(503), resp(503), resp(200)},
+		errs:      []error{nil, nil, nil},
+	}
+	rt := Retry(3, retriable)(tripper)
+
+	got, err := rt.Do(&http.Request{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.StatusCode != 200 {
+		t.Errorf("got status %d, want 200", got.StatusCode)
+	}
+	if tripper.calls != 3 {
+		t.Errorf("next called %d times, want 3", tripper.calls)
+	}
+}
+
+func TestRetryStopsAtMax(t *testing.T) {
+	tripper := &fakeTripper{
+		responses: []*http.Response{resp(503)},
+		errs:      []error{nil},
+	}
+	rt := Retry(2, retriable)(tripper)
+
+	got, err := rt.Do(&http.Request{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.StatusCode != 503 {
+		t.Errorf("got status %d, want 503 (exhausted retries)", got.StatusCode)
+	}
+	// One initial attempt plus two retries.
+	if tripper.calls != 3 {
+		t.Errorf("next called %d times, want 3", tripper.calls)
+	}
+}
+
+func TestRetryDoesNotRetrySuccess(t *testing.T) {
+	tripper := &fakeTripper{
+		responses: []*http.Response{resp(200)},
+		errs:      []error{nil},
+	}
+	rt := Retry(5, retriable)(tripper)
+
+	if _, err := rt.Do(&http.Request{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if tripper.calls != 1 {
+		t.Errorf("next called %d times, want 1", tripper.calls)
+	}
+}
+
+func TestRetryPropagatesTransportError(t *testing.T) {
+	wantErr := errors.New("boom")
+	tripper := &fakeTripper{
+		responses: []*http.Response{nil},
+		errs:      []error{wantErr},
+	}
+	rt := Retry(0, retriable)(tripper)
+
+	if _, err := rt.Do(&http.Request{}); err != wantErr {
+		t.Errorf("err = %v, want %v", err, wantErr)
+	}
+}
+
+func TestLoggerRecordsMethodPathStatus(t *testing.T) {
+	tripper := &fakeTripper{
+		responses: []*http.Response{resp(200)},
+		errs:      []error{nil},
+	}
+	var buf bytes.Buffer
+	rt := Logger(&buf)(tripper)
+
+	req := &http.Request{Method: "GET", URL: &url.URL{Path: "/r/all.json"}}
+	if _, err := rt.Do(req); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	line := buf.String()
+	if !strings.HasPrefix(line, "GET /r/all.json 200 ") {
+		t.Errorf("log line = %q, want prefix %q", line, "GET /r/all.json 200 ")
+	}
+}
+
+func TestStacking(t *testing.T) {
+	tripper := &fakeTripper{
+		responses: []*http.Response{resp(503), resp(200)},
+		errs:      []error{nil, nil},
+	}
+	var buf bytes.Buffer
+
+	var rt reddit.RoundTripper = tripper
+	rt = Retry(1, retriable)(rt)
+	rt = Logger(&buf)(rt)
+
+	if _, err := rt.Do(&http.Request{Method: "GET", URL: &url.URL{Path: "/x"}}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Logger wraps Retry, so it should see the final, successful response
+	// after the retry has already happened underneath it.
+	if !strings.Contains(buf.String(), "200") {
+		t.Errorf("log line = %q, want it to record the final status 200", buf.String())
+	}
+	if tripper.calls != 2 {
+		t.Errorf("next called %d times, want 2", tripper.calls)
+	}
+}
+
+func TestRetryClosesDiscardedResponseBodies(t *testing.T) {
+	discarded := &closeCountingBody{}
+	tripper := &fakeTripper{
+		responses: []*http.Response{
+			{StatusCode: 503, Body: discarded},
+			{StatusCode: 200},
+		},
+		errs: []error{nil, nil},
+	}
+	rt := Retry(1, retriable)(tripper)
+
+	if _, err := rt.Do(&http.Request{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !discarded.closed {
+		t.Error("retrier did not close the discarded response's body")
+	}
+}
+
+// tripperRecordingBodies records the body text it read on each call, so
+// tests can confirm a retry rewound the body rather than resending an
+// already-consumed (and now empty) reader.
+type tripperRecordingBodies struct {
+	statuses []int
+	bodies   []string
+}
+
+func (t *tripperRecordingBodies) Do(req *http.Request) (*http.Response, error) {
+	i := len(t.bodies)
+	b, _ := io.ReadAll(req.Body)
+	t.bodies = append(t.bodies, string(b))
+
+	status := t.statuses[len(t.statuses)-1]
+	if i < len(t.statuses) {
+		status = t.statuses[i]
+	}
+	return &http.Response{StatusCode: status}, nil
+}
+
+func TestRetryRewindsBodyViaGetBody(t *testing.T) {
+	tripper := &tripperRecordingBodies{statuses: []int{503, 200}}
+	rt := Retry(1, retriable)(tripper)
+
+	req := &http.Request{
+		Body:    io.NopCloser(strings.NewReader("body=1")),
+		GetBody: func() (io.ReadCloser, error) { return io.NopCloser(strings.NewReader("body=1")), nil },
+	}
+
+	if _, err := rt.Do(req); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := []string{"body=1", "body=1"}; !reflect.DeepEqual(tripper.bodies, want) {
+		t.Errorf("bodies sent = %v, want %v (retry should rewind via GetBody)", tripper.bodies, want)
+	}
+}
+
+func TestRetryDoesNotRetryRequestWithUnrewindableBody(t *testing.T) {
+	tripper := &fakeTripper{
+		responses: []*http.Response{resp(503)},
+		errs:      []error{nil},
+	}
+	rt := Retry(3, retriable)(tripper)
+
+	req := &http.Request{Body: io.NopCloser(strings.NewReader("body=1"))}
+	got, err := rt.Do(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.StatusCode != 503 {
+		t.Errorf("got status %d, want 503", got.StatusCode)
+	}
+	if tripper.calls != 1 {
+		t.Errorf("next called %d times, want 1 (no retry without GetBody)", tripper.calls)
+	}
+}
+
+func TestRetryStopsOnContextCancel(t *testing.T) {
+	tripper := &fakeTripper{
+		responses: []*http.Response{resp(503)},
+		errs:      []error{nil},
+	}
+	rt := Retry(5, retriable)(tripper)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	req, err := http.NewRequestWithContext(ctx, "GET", "http://reddit.com", nil)
+	if err != nil {
+		t.Fatalf("NewRequestWithContext: %v", err)
+	}
+
+	start := time.Now()
+	if _, err := rt.Do(req); err != ctx.Err() {
+		t.Errorf("err = %v, want %v", err, ctx.Err())
+	}
+	if elapsed := time.Since(start); elapsed > 50*time.Millisecond {
+		t.Errorf("cancelled backoff took %v, want it to return promptly", elapsed)
+	}
+	if tripper.calls != 1 {
+		t.Errorf("next called %d times, want 1 (no retry after cancel)", tripper.calls)
+	}
+}
@@ -0,0 +1,31 @@
+package reddit
+
+import (
+	"io"
+	"net/http"
+	"strings"
+)
+
+// mockClient stores the request it receives and returns a canned response.
+// If response is nil, it returns an empty 200 OK.
+type mockClient struct {
+	request *http.Request
+
+	response *http.Response
+	err      error
+}
+
+func (m *mockClient) Do(r *http.Request) (*http.Response, error) {
+	m.request = r
+	if m.err != nil {
+		return nil, m.err
+	}
+	if m.response != nil {
+		return m.response, nil
+	}
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{},
+		Body:       io.NopCloser(strings.NewReader("")),
+	}, nil
+}
@@ -0,0 +1,79 @@
+package reddit
+
+import "encoding/json"
+
+// parser decodes a raw Reddit API response into the entities it contains.
+type parser interface {
+	parse(blob json.RawMessage) ([]*Comment, []*Post, []*Message, error)
+}
+
+// thing is the envelope Reddit wraps every API object in.
+type thing struct {
+	Kind string          `json:"kind"`
+	Data json.RawMessage `json:"data"`
+}
+
+// listing is the envelope Reddit wraps a page of things in.
+type listing struct {
+	Data struct {
+		Children []thing `json:"children"`
+	} `json:"data"`
+}
+
+const (
+	commentKind = "t1"
+	postKind    = "t3"
+	messageKind = "t4"
+)
+
+// jsonParser parses the JSON Reddit's listing endpoints return.
+type jsonParser struct{}
+
+func newParser() parser {
+	return &jsonParser{}
+}
+
+func (j *jsonParser) parse(
+	blob json.RawMessage,
+) ([]*Comment, []*Post, []*Message, error) {
+	var things []thing
+
+	var single thing
+	if err := json.Unmarshal(blob, &single); err == nil && single.Kind != "" {
+		things = []thing{single}
+	} else {
+		var list listing
+		if err := json.Unmarshal(blob, &list); err != nil {
+			return nil, nil, nil, err
+		}
+		things = list.Data.Children
+	}
+
+	var comments []*Comment
+	var posts []*Post
+	var messages []*Message
+	for _, t := range things {
+		switch t.Kind {
+		case commentKind:
+			c := &Comment{}
+			if err := json.Unmarshal(t.Data, c); err != nil {
+				return nil, nil, nil, err
+			}
+			comments = append(comments, c)
+		case postKind:
+			p := &Post{}
+			if err := json.Unmarshal(t.Data, p); err != nil {
+				return nil, nil, nil, err
+			}
+			posts = append(posts, p)
+		case messageKind:
+			m := &Message{}
+			if err := json.Unmarshal(t.Data, m); err != nil {
+				return nil, nil, nil, err
+			}
+			messages = append(messages, m)
+		}
+	}
+
+	return comments, posts, messages, nil
+}
@@ -0,0 +1,238 @@
+package reddit
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+var (
+	// scheme is a map of TLS=[true|false] to the scheme for that setting.
+	scheme = map[bool]string{
+		true:  "https",
+		false: "http",
+	}
+	formEncoding = map[string][]string{
+		"content-type": {"application/x-www-form-urlencoded"},
+	}
+)
+
+type reaperConfig struct {
+	client     RoundTripper
+	parser     parser
+	hostname   string
+	reapSuffix string
+	tls        bool
+	// rate is the fallback pacing used when Reddit's rate limit headers
+	// are absent, e.g. for hosts other than reddit.com or unauthenticated
+	// requests.
+	rate time.Duration
+	// interceptors wrap every request the reaper dispatches, outermost
+	// first.
+	interceptors []Interceptor
+	// agent is sent as the User-Agent header on every request. Reddit
+	// throttles or blocks clients using Go's default User-Agent, so this
+	// should always be set outside of tests.
+	agent string
+}
+
+// reaper is a high level api for Reddit HTTP requests.
+type reaper interface {
+	// reap executes a GET request to Reddit and returns the elements from
+	// the endpoint.
+	reap(ctx context.Context, path string, values map[string]string) (Harvest, error)
+	// sow executes a POST request to Reddit.
+	sow(ctx context.Context, path string, values map[string]string) error
+	// getSow executes a POST request to Reddit and parses the response,
+	// typically the thing the POST just created.
+	getSow(ctx context.Context, path string, values map[string]string) (Harvest, error)
+}
+
+type reaperImpl struct {
+	cli        RoundTripper
+	parser     parser
+	hostname   string
+	reapSuffix string
+	scheme     string
+	lim        limiter
+	agent      string
+}
+
+func newReaper(c reaperConfig) reaper {
+	return &reaperImpl{
+		cli:        chainInterceptors(c.client, c.interceptors),
+		parser:     c.parser,
+		hostname:   c.hostname,
+		reapSuffix: c.reapSuffix,
+		scheme:     scheme[c.tls],
+		lim:        newLimiter(c.rate),
+		agent:      c.agent,
+	}
+}
+
+func (r *reaperImpl) reap(
+	ctx context.Context,
+	path string,
+	values map[string]string,
+) (Harvest, error) {
+	resp, err := r.do(ctx, &http.Request{
+		Method: "GET",
+		URL:    r.url(r.path(path, r.reapSuffix), values),
+		Host:   r.hostname,
+	})
+	if err != nil {
+		return Harvest{}, err
+	}
+
+	comments, posts, messages, err := r.parser.parse(resp)
+	return Harvest{
+		Comments: comments,
+		Posts:    posts,
+		Messages: messages,
+	}, err
+}
+
+func (r *reaperImpl) sow(
+	ctx context.Context,
+	path string,
+	values map[string]string,
+) error {
+	body, length, getBody := encodeForm(values)
+	_, err := r.do(ctx, &http.Request{
+		Method:        "POST",
+		Header:        formEncoding,
+		Host:          r.hostname,
+		URL:           r.url(path, nil),
+		Body:          body,
+		GetBody:       getBody,
+		ContentLength: length,
+	})
+	return err
+}
+
+func (r *reaperImpl) getSow(
+	ctx context.Context,
+	path string,
+	values map[string]string,
+) (Harvest, error) {
+	if values == nil {
+		values = map[string]string{}
+	}
+	values["api_type"] = "json"
+
+	body, length, getBody := encodeForm(values)
+	resp, err := r.do(ctx, &http.Request{
+		Method:        "POST",
+		Header:        formEncoding,
+		Host:          r.hostname,
+		URL:           r.url(path, nil),
+		Body:          body,
+		GetBody:       getBody,
+		ContentLength: length,
+	})
+	if err != nil {
+		return Harvest{}, err
+	}
+
+	comments, posts, messages, err := r.parser.parse(resp)
+	return Harvest{
+		Comments: comments,
+		Posts:    posts,
+		Messages: messages,
+	}, err
+}
+
+// do waits for the limiter's next open slot, dispatches req through the
+// interceptor chain, and feeds the response's rate limit headers back into
+// the limiter before buffering its body.
+func (r *reaperImpl) do(ctx context.Context, req *http.Request) ([]byte, error) {
+	if err := r.lim.wait(ctx); err != nil {
+		return nil, err
+	}
+
+	r.setAgent(req)
+
+	resp, err := r.cli.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	r.lim.update(resp.Header)
+
+	var buf bytes.Buffer
+	if _, err := buf.ReadFrom(resp.Body); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// setAgent sets the User-Agent header on req, copying its existing header
+// first rather than mutating it in place: sow/getSow share the package
+// level formEncoding map across every request, and mutating that shared
+// map would leak the header onto unrelated requests.
+func (r *reaperImpl) setAgent(req *http.Request) {
+	if r.agent == "" {
+		return
+	}
+
+	header := http.Header{}
+	for k, v := range req.Header {
+		header[k] = v
+	}
+	header.Set("User-Agent", r.agent)
+	req.Header = header
+}
+
+func (r *reaperImpl) url(path string, values map[string]string) *url.URL {
+	return &url.URL{
+		Scheme:   r.scheme,
+		Host:     r.hostname,
+		Path:     path,
+		RawQuery: r.formatValues(values).Encode(),
+	}
+}
+
+func (r *reaperImpl) path(p string, suff string) string {
+	if suff == "" || strings.HasSuffix(p, suff) {
+		return p
+	}
+
+	return p + suff
+}
+
+func (r *reaperImpl) formatValues(values map[string]string) url.Values {
+	formattedValues := url.Values{}
+
+	for key, value := range values {
+		formattedValues[key] = []string{value}
+	}
+
+	return formattedValues
+}
+
+// encodeForm form-encodes values into a request body, reporting the body,
+// its content length, and a GetBody func that produces a fresh copy of the
+// body so interceptors like middleware.Retry can resend the request. A nil
+// or empty map produces an empty body.
+func encodeForm(values map[string]string) (io.ReadCloser, int64, func() (io.ReadCloser, error)) {
+	if len(values) == 0 {
+		getBody := func() (io.ReadCloser, error) { return http.NoBody, nil }
+		return http.NoBody, 0, getBody
+	}
+
+	formatted := url.Values{}
+	for key, value := range values {
+		formatted[key] = []string{value}
+	}
+
+	encoded := formatted.Encode()
+	getBody := func() (io.ReadCloser, error) {
+		return io.NopCloser(strings.NewReader(encoded)), nil
+	}
+	return io.NopCloser(strings.NewReader(encoded)), int64(len(encoded)), getBody
+}
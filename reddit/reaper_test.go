@@ -1,9 +1,9 @@
 package reddit
 
 import (
+	"context"
 	"net/http"
 	"net/url"
-	"sync"
 	"testing"
 	"time"
 
@@ -24,7 +24,7 @@ func TestNew(t *testing.T) {
 		parser:   par,
 		hostname: "com",
 		scheme:   "https",
-		mu:       &sync.Mutex{},
+		lim:      newLimiter(0),
 	}
 
 	if diff := pretty.Compare(newReaper(cfg), expected); diff != "" {
@@ -92,10 +92,10 @@ func TestReap(t *testing.T) {
 			parser:   parserWhich(expected),
 			hostname: "com",
 			scheme:   "http",
-			mu:       &sync.Mutex{},
+			lim:      newLimiter(0),
 		}
 
-		Harvest, err := r.reap(test.path, test.values)
+		Harvest, err := r.reap(context.Background(), test.path, test.values)
 		if err != nil {
 			t.Errorf("Error reaping input %d: %v", i, err)
 		}
@@ -163,16 +163,17 @@ func TestSow(t *testing.T) {
 			parser:   &mockParser{},
 			hostname: "com",
 			scheme:   "http",
-			mu:       &sync.Mutex{},
+			lim:      newLimiter(0),
 		}
 
-		if err := r.sow(test.path, test.values); err != nil {
+		if err := r.sow(context.Background(), test.path, test.values); err != nil {
 			t.Errorf("Error reaping input %d: %v", i, err)
 		}
 
 		// Modify the test to only compare relevant fields
-		// because we can't directly compare Body readers
+		// because we can't directly compare Body readers or GetBody funcs
 		request := c.request
+		request.GetBody = nil
 
 		// For tests with values, check content length instead of body content
 		if test.values != nil {
@@ -193,29 +194,56 @@ func TestSow(t *testing.T) {
 }
 
 func TestRateBlockReap(t *testing.T) {
-	testRateBlock(func(r reaper) { r.reap("", nil) }, t)
+	testRateBlock(func(r reaper) { r.reap(context.Background(), "", nil) }, t)
 }
 
 func TestRateBlockSow(t *testing.T) {
-	testRateBlock(func(r reaper) { r.sow("", nil) }, t)
+	testRateBlock(func(r reaper) { r.sow(context.Background(), "", nil) }, t)
+}
+
+func TestAgentHeader(t *testing.T) {
+	c := &mockClient{}
+	r := &reaperImpl{
+		cli:    c,
+		parser: &mockParser{},
+		lim:    newLimiter(0),
+		agent:  "graw-test/1.0",
+	}
+
+	if _, err := r.reap(context.Background(), "", nil); err != nil {
+		t.Fatalf("reap: %v", err)
+	}
+
+	if got := c.request.Header.Get("User-Agent"); got != "graw-test/1.0" {
+		t.Errorf("User-Agent = %q, want %q", got, "graw-test/1.0")
+	}
+
+	if err := r.sow(context.Background(), "", nil); err != nil {
+		t.Fatalf("sow: %v", err)
+	}
+
+	if got := c.request.Header.Get("User-Agent"); got != "graw-test/1.0" {
+		t.Errorf("User-Agent = %q, want %q", got, "graw-test/1.0")
+	}
+	if formEncoding["User-Agent"] != nil {
+		t.Errorf("setAgent mutated the shared formEncoding map")
+	}
 }
 
 func testRateBlock(f func(reaper), t *testing.T) {
 	start := time.Now()
+	rate := 10 * time.Millisecond
 	r := &reaperImpl{
 		cli:    &mockClient{},
 		parser: &mockParser{},
-		rate:   10 * time.Millisecond,
-		last:   start,
-		mu:     &sync.Mutex{},
+		lim:    newLimiter(rate),
 	}
+	r.lim.(*rateLimiter).nextSlot = start.Add(rate)
 
 	f(r)
 	end := time.Now()
 
-	if block := end.Sub(start); block < r.rate {
-		t.Errorf("wanted block for %v; blocked for %v", r.rate, block)
-	} else if r.last == start {
-		t.Errorf("wanted updated timestamp; found same timestamp")
+	if block := end.Sub(start); block < rate {
+		t.Errorf("wanted block for %v; blocked for %v", rate, block)
 	}
 }
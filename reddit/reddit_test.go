@@ -1,9 +1,9 @@
 package reddit
 
 import (
+	"context"
 	"net/http"
 	"net/url"
-	"sync"
 	"testing"
 )
 
@@ -23,7 +23,7 @@ func TestAccount(t *testing.T) {
 			testCase{
 				name: "Reply",
 				f: func(b Bot) error {
-					return b.Reply("name", "text")
+					return b.Reply(context.Background(), "name", "text")
 				},
 				correct: http.Request{
 					Method: "POST",
@@ -44,7 +44,7 @@ func TestAccount(t *testing.T) {
 			testCase{
 				name: "GetReply",
 				f: func(b Bot) error {
-					_, err := b.GetReply("name", "text")
+					_, err := b.GetReply(context.Background(), "name", "text")
 					return err
 				},
 				correct: http.Request{
@@ -67,7 +67,7 @@ func TestAccount(t *testing.T) {
 			testCase{
 				name: "SendMessage",
 				f: func(b Bot) error {
-					return b.SendMessage("user", "subject", "text")
+					return b.SendMessage(context.Background(), "user", "subject", "text")
 				},
 				correct: http.Request{
 					Method: "POST",
@@ -89,7 +89,7 @@ func TestAccount(t *testing.T) {
 			testCase{
 				name: "PostSelf",
 				f: func(b Bot) error {
-					return b.PostSelf("self", "title", "text")
+					return b.PostSelf(context.Background(), "self", "title", "text")
 				},
 				correct: http.Request{
 					Method: "POST",
@@ -112,7 +112,7 @@ func TestAccount(t *testing.T) {
 			testCase{
 				name: "GetPostSelf",
 				f: func(b Bot) error {
-					_, err := b.GetPostSelf("self", "title", "text")
+					_, err := b.GetPostSelf(context.Background(), "self", "title", "text")
 					return err
 				},
 				correct: http.Request{
@@ -137,7 +137,7 @@ func TestAccount(t *testing.T) {
 			testCase{
 				name: "PostLink",
 				f: func(b Bot) error {
-					return b.PostLink("link", "title", "url")
+					return b.PostLink(context.Background(), "link", "title", "url")
 				},
 				correct: http.Request{
 					Method: "POST",
@@ -160,7 +160,7 @@ func TestAccount(t *testing.T) {
 			testCase{
 				name: "GetPostLink",
 				f: func(b Bot) error {
-					_, err := b.GetPostLink("link", "title", "url")
+					_, err := b.GetPostLink(context.Background(), "link", "title", "url")
 					return err
 				},
 				correct: http.Request{
@@ -192,7 +192,7 @@ func TestScanner(t *testing.T) {
 			testCase{
 				name: "Listing",
 				f: func(b Bot) error {
-					_, err := b.Listing("/r/all", "ref")
+					_, err := b.Listing(context.Background(), "/r/all", "ref")
 					return err
 				},
 				correct: http.Request{
@@ -217,7 +217,7 @@ func TestLurker(t *testing.T) {
 				name: "Thread",
 				err:  ThreadDoesNotExistErr,
 				f: func(b Bot) error {
-					_, err := b.Thread("/permalink")
+					_, err := b.Thread(context.Background(), "/permalink")
 					return err
 				},
 				correct: http.Request{
@@ -243,7 +243,7 @@ func testRequests(cases []testCase, t *testing.T) {
 		hostname:   "reddit.com",
 		reapSuffix: ".json",
 		scheme:     "https",
-		mu:         &sync.Mutex{},
+		lim:        newLimiter(0),
 	}
 	b := &bot{
 		Account: newAccount(r),
@@ -0,0 +1,30 @@
+package reddit
+
+import "context"
+
+// Scanner reads listings of content from Reddit.
+type Scanner interface {
+	// Listing returns the things in a listing at path, starting after
+	// the thing named before (pass "" to start from the top).
+	Listing(ctx context.Context, path, before string) (*Harvest, error)
+}
+
+type scannerImpl struct {
+	rep reaper
+}
+
+func newScanner(r reaper) Scanner {
+	return &scannerImpl{rep: r}
+}
+
+func (s *scannerImpl) Listing(ctx context.Context, path, before string) (*Harvest, error) {
+	h, err := s.rep.reap(ctx, path, map[string]string{
+		"before":   before,
+		"limit":    "100",
+		"raw_json": "1",
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &h, nil
+}
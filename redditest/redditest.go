@@ -0,0 +1,187 @@
+// Package redditest is a testing harness for bots built on graw/reddit. It
+// gives downstream bot authors an in-memory reddit.Bot, backed by a
+// Recorder that captures every outgoing request and can be taught to
+// respond with fixtures, so bot behavior can be asserted without talking
+// to Reddit.
+package redditest
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sync"
+	"testing"
+
+	"github.com/turnage/graw/reddit"
+)
+
+// NewBot returns a reddit.Bot whose requests are captured by the returned
+// Recorder instead of being sent to Reddit.
+func NewBot(t *testing.T) (reddit.Bot, *Recorder) {
+	t.Helper()
+
+	rec := &Recorder{}
+	bot, err := reddit.NewBot(reddit.BotConfig{
+		Hostname: "reddit.com",
+		TLS:      true,
+		Client:   &http.Client{Transport: rec},
+	})
+	if err != nil {
+		t.Fatalf("redditest: constructing bot: %v", err)
+	}
+	return bot, rec
+}
+
+// matcher is a registered fixture response for requests matching method,
+// a path regex, and optionally a predicate over the request's form values.
+type matcher struct {
+	method   string
+	path     *regexp.Regexp
+	formPred func(url.Values) bool
+	status   int
+	body     []byte
+}
+
+// MatchOption narrows a Recorder.Respond registration beyond method and
+// path.
+type MatchOption func(*matcher)
+
+// WithFormValue requires a matched request's form to have value set for
+// key.
+func WithFormValue(key, value string) MatchOption {
+	return func(m *matcher) {
+		prev := m.formPred
+		m.formPred = func(form url.Values) bool {
+			return form.Get(key) == value && (prev == nil || prev(form))
+		}
+	}
+}
+
+// Recorder is an http.RoundTripper that records every request it sees and
+// answers them from registered fixtures, falling back to an empty 200 OK.
+type Recorder struct {
+	mu       sync.Mutex
+	requests []*http.Request
+	forms    []url.Values
+	matchers []matcher
+}
+
+// Respond registers a fixture response: requests whose method matches
+// method (case-sensitive, e.g. "POST") and whose path matches the regular
+// expression pathRegex get back status and body. Matchers are tried in
+// registration order, so register more specific matchers first. opts can
+// narrow the match further, e.g. by required form field.
+func (r *Recorder) Respond(
+	method, pathRegex string,
+	status int,
+	body []byte,
+	opts ...MatchOption,
+) error {
+	re, err := regexp.Compile(pathRegex)
+	if err != nil {
+		return err
+	}
+
+	m := matcher{method: method, path: re, status: status, body: body}
+	for _, opt := range opts {
+		opt(&m)
+	}
+
+	r.mu.Lock()
+	r.matchers = append(r.matchers, m)
+	r.mu.Unlock()
+	return nil
+}
+
+// Requests returns every request the Recorder has seen so far, in the
+// order it saw them.
+func (r *Recorder) Requests() []*http.Request {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	out := make([]*http.Request, len(r.requests))
+	copy(out, r.requests)
+	return out
+}
+
+// LastForm returns the form values decoded from the most recent request's
+// body, or nil if the Recorder hasn't seen a request yet.
+func (r *Recorder) LastForm() url.Values {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if len(r.forms) == 0 {
+		return nil
+	}
+	return r.forms[len(r.forms)-1]
+}
+
+// RoundTrip implements http.RoundTripper.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	form, err := readForm(req)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	r.requests = append(r.requests, req)
+	r.forms = append(r.forms, form)
+	m, ok := r.match(req, form)
+	r.mu.Unlock()
+
+	if !ok {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{},
+			Body:       io.NopCloser(bytes.NewReader(nil)),
+		}, nil
+	}
+
+	return &http.Response{
+		StatusCode: m.status,
+		Header:     http.Header{},
+		Body:       io.NopCloser(bytes.NewReader(m.body)),
+	}, nil
+}
+
+// match must be called with r.mu held.
+func (r *Recorder) match(req *http.Request, form url.Values) (matcher, bool) {
+	for _, m := range r.matchers {
+		if m.method != "" && m.method != req.Method {
+			continue
+		}
+		if m.path != nil && !m.path.MatchString(req.URL.Path) {
+			continue
+		}
+		if m.formPred != nil && !m.formPred(form) {
+			continue
+		}
+		return m, true
+	}
+	return matcher{}, false
+}
+
+// readForm decodes a request's url-encoded body into form values, leaving
+// the body readable for anything downstream (there is nothing downstream
+// here, but RoundTripper implementations are expected not to consume
+// bodies they don't own).
+func readForm(req *http.Request) (url.Values, error) {
+	if req.Body == nil {
+		return url.Values{}, nil
+	}
+
+	raw, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+	req.Body.Close()
+	req.Body = io.NopCloser(bytes.NewReader(raw))
+
+	form, err := url.ParseQuery(string(raw))
+	if err != nil {
+		return url.Values{}, nil
+	}
+	return form, nil
+}
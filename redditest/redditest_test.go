@@ -0,0 +1,78 @@
+package redditest
+
+import (
+	"context"
+	"testing"
+)
+
+func TestRespondAssertsForm(t *testing.T) {
+	bot, rec := NewBot(t)
+
+	fixture := []byte(`{"json":{"data":{"things":[]}}}`)
+	if err := rec.Respond("POST", "^/api/submit$", 200, fixture); err != nil {
+		t.Fatalf("Respond: %v", err)
+	}
+
+	if err := bot.PostSelf(context.Background(), "golang", "hello", "world"); err != nil {
+		t.Fatalf("PostSelf: %v", err)
+	}
+
+	reqs := rec.Requests()
+	if len(reqs) != 1 {
+		t.Fatalf("got %d requests, want 1", len(reqs))
+	}
+	if reqs[0].URL.Path != "/api/submit" {
+		t.Errorf("path = %s, want /api/submit", reqs[0].URL.Path)
+	}
+
+	form := rec.LastForm()
+	for key, want := range map[string]string{
+		"kind":  "self",
+		"sr":    "golang",
+		"title": "hello",
+		"text":  "world",
+	} {
+		if got := form.Get(key); got != want {
+			t.Errorf("form[%s] = %q, want %q", key, got, want)
+		}
+	}
+}
+
+func TestRespondFixtureFlowsThroughParserIntoHarvest(t *testing.T) {
+	bot, rec := NewBot(t)
+
+	fixture := []byte(`{
+		"data": {
+			"children": [
+				{"kind": "t3", "data": {"id": "abc", "title": "hi", "selftext": "body"}},
+				{"kind": "t1", "data": {"id": "def", "body": "a comment"}}
+			]
+		}
+	}`)
+	if err := rec.Respond("GET", `^/r/golang\.json$`, 200, fixture); err != nil {
+		t.Fatalf("Respond: %v", err)
+	}
+
+	harvest, err := bot.Listing(context.Background(), "/r/golang", "")
+	if err != nil {
+		t.Fatalf("Listing: %v", err)
+	}
+
+	if len(harvest.Posts) != 1 || harvest.Posts[0].Title != "hi" {
+		t.Errorf("Posts = %+v, want one post titled %q", harvest.Posts, "hi")
+	}
+	if len(harvest.Comments) != 1 || harvest.Comments[0].Body != "a comment" {
+		t.Errorf("Comments = %+v, want one comment with body %q", harvest.Comments, "a comment")
+	}
+}
+
+func TestRequestsWithoutAFixtureGetAnEmptyOK(t *testing.T) {
+	bot, rec := NewBot(t)
+
+	if err := bot.Reply(context.Background(), "t1_abc", "thanks"); err != nil {
+		t.Fatalf("Reply: %v", err)
+	}
+	if got := len(rec.Requests()); got != 1 {
+		t.Errorf("got %d requests, want 1", got)
+	}
+}